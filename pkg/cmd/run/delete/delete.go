@@ -0,0 +1,498 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+// maxDeleteConcurrency bounds the number of in-flight delete requests so a
+// large batch doesn't hammer the API or blow past secondary rate limits.
+const maxDeleteConcurrency = 10
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID  string
+	Prompt bool
+
+	RunIDs           []string
+	WorkflowSelector string
+	Status           string
+	Branch           string
+	Event            string
+	OlderThan        time.Duration
+	Limit            int
+	All              bool
+	Confirmed        bool
+
+	Cancel  bool
+	Wait    bool
+	Timeout time.Duration
+
+	Trash bool
+	Purge bool
+
+	Verbose bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Timeout:    2 * time.Minute,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<run-id>...]",
+		Short: "Delete workflow runs",
+		Long: heredoc.Doc(`
+			Delete one or more workflow runs.
+
+			Runs can be selected by passing one or more run IDs, or resolved from
+			the --status, --workflow, --branch, --event, and --older-than filters.
+			Use --all to target every run that matches the given filters instead
+			of just the most recent ones.
+
+			Deleting a run that hasn't completed normally fails. Pass --cancel to
+			cancel such runs first; add --wait to wait for the cancellation to
+			take effect (up to --timeout, default 2m) before retrying the delete.
+
+			Since the API can't undelete a run, by default an interactive
+			selection archives each run's metadata and logs to a local trash
+			journal instead of deleting it, so it can be looked up afterward
+			with "gh run restore". Pass --trash to opt into the same
+			behavior non-interactively, or --purge (alias --no-trash) to
+			skip the journal and delete runs outright.
+		`),
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.RunIDs = args
+			if len(args) == 1 {
+				opts.RunID = args[0]
+			}
+
+			if len(args) == 0 && !opts.hasFilters() && !opts.All {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("run ID, a filter flag, or --all required when not running interactively")
+				}
+				opts.Prompt = true
+			}
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed && (len(args) != 1 || opts.hasFilters() || opts.All) {
+				return cmdutil.FlagErrorf("--yes required to delete multiple runs when not running interactively")
+			}
+
+			if !cmd.Flags().Changed("trash") && !cmd.Flags().Changed("purge") && !cmd.Flags().Changed("no-trash") {
+				opts.Trash = opts.Prompt
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Status, "status", "", "Filter by run status: {queued|completed|in_progress|...}")
+	cmd.Flags().StringVarP(&opts.WorkflowSelector, "workflow", "w", "", "Filter by workflow")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Filter by branch")
+	cmd.Flags().StringVarP(&opts.Event, "event", "e", "", "Filter by event type")
+	cmd.Flags().DurationVar(&opts.OlderThan, "older-than", 0, "Filter to runs older than `DURATION`, e.g. \"720h\"")
+	cmd.Flags().IntVar(&opts.Limit, "limit", 20, "Maximum number of runs to resolve from filters")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Select all runs matching the given filters")
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Don't prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.Cancel, "cancel", false, "Cancel runs that haven't completed before deleting them")
+	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for a cancelled run to finish before retrying the delete")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Maximum time to wait for a cancelled run to finish")
+	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false, "Print the details of each run before deleting it when not running interactively")
+	cmd.Flags().BoolVar(&opts.Trash, "trash", false, "Save run metadata and logs to a local trash journal instead of deleting (default for interactive selection)")
+	cmd.Flags().BoolVar(&opts.Purge, "purge", false, "Permanently delete runs instead of trashing them")
+	cmd.Flags().BoolVar(&opts.Purge, "no-trash", false, "Alias for --purge")
+	_ = cmd.Flags().MarkHidden("no-trash")
+
+	return cmd
+}
+
+func (opts *DeleteOptions) hasFilters() bool {
+	return opts.Status != "" || opts.WorkflowSelector != "" || opts.Branch != "" || opts.Event != "" || opts.OlderThan > 0
+}
+
+func (opts *DeleteOptions) isTrashing() bool {
+	return opts.Trash && !opts.Purge
+}
+
+func runDelete(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	var runs []shared.Run
+	switch {
+	case opts.Prompt:
+		selected, err := promptForRuns(client, opts, repo)
+		if err != nil {
+			return err
+		}
+		runs = selected
+		// The multi-select picker already doubles as the user's confirmation.
+		opts.Confirmed = true
+	case len(opts.RunIDs) > 0 || opts.RunID != "":
+		runIDs := opts.RunIDs
+		if len(runIDs) == 0 {
+			runIDs = []string{opts.RunID}
+		}
+		for _, runID := range runIDs {
+			run, err := shared.GetRun(client, repo, runID)
+			if err != nil {
+				var httpErr api.HTTPError
+				if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+					return fmt.Errorf("Could not find any workflow run with ID %s", runID)
+				}
+				return fmt.Errorf("failed to get run: %w", err)
+			}
+			runs = append(runs, *run)
+		}
+	default:
+		resolved, err := resolveRunsWithFilter(client, repo, opts)
+		if err != nil {
+			return err
+		}
+		if len(resolved) == 0 {
+			return errors.New("no runs matched the given filters")
+		}
+		runs = resolved
+	}
+
+	switch {
+	case len(runs) > 1 && opts.IO.CanPrompt() && !opts.Confirmed:
+		printRunHeaders(opts, cs, runs)
+		confirmed := false
+		//nolint:staticcheck // SA1019: prompt.Confirm is deprecated: use Prompter.Confirm
+		err := prompt.Confirm(fmt.Sprintf("Delete %d workflow runs?", len(runs)), &confirmed)
+		if err != nil {
+			return fmt.Errorf("failed to prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	case !opts.IO.CanPrompt() && opts.Verbose:
+		printRunHeaders(opts, cs, runs)
+	}
+
+	if len(runs) == 1 {
+		if err := processRun(client, repo, runs[0], opts); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			if opts.isTrashing() {
+				fmt.Fprintf(opts.IO.Out, "%s Run trashed; restore it with `gh run restore %d`.\n", cs.SuccessIcon(), runs[0].ID)
+			} else {
+				fmt.Fprintf(opts.IO.Out, "%s Request to delete workflow submitted.\n", cs.SuccessIcon())
+			}
+		}
+		return nil
+	}
+
+	results := deleteRuns(client, repo, runs, opts)
+
+	verb := "Deleted"
+	if opts.isTrashing() {
+		verb = "Trashed"
+	}
+
+	var failed int
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to delete run %s: %s\n", cs.FailureIcon(), result.runID, result.err)
+			continue
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s %s run %s.\n", cs.SuccessIcon(), verb, result.runID)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d runs", failed, len(runs))
+	}
+
+	return nil
+}
+
+// printRunHeaders renders shared.RenderRunHeader for each run, giving the
+// user something concrete to review before a destructive action proceeds.
+func printRunHeaders(opts *DeleteOptions, cs *iostreams.ColorScheme, runs []shared.Run) {
+	for _, run := range runs {
+		fmt.Fprintln(opts.IO.Out, shared.RenderRunHeader(cs, run))
+		fmt.Fprintln(opts.IO.Out)
+	}
+}
+
+func promptForRuns(client *api.Client, opts *DeleteOptions, repo ghrepo.Interface) ([]shared.Run, error) {
+	// Only runs that haven't completed yet are offered here; completed runs
+	// are left out of the picker.
+	runs, err := shared.GetRunsWithFilter(client, repo, 0, 50, func(run shared.Run) bool {
+		return run.Status != shared.Completed
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil, errors.New("found no completed runs to delete")
+	}
+
+	candidates := make([]string, len(runs))
+	runForCandidate := make(map[string]shared.Run, len(runs))
+	for i, run := range runs {
+		candidate := fmt.Sprintf("%d (%s)", run.ID, run.Status)
+		candidates[i] = candidate
+		runForCandidate[candidate] = run
+	}
+
+	var selected []string
+	//nolint:staticcheck // SA1019: survey.AskOne is deprecated in favor of Prompter.MultiSelect
+	err = prompt.SurveyAskOne(&survey.MultiSelect{
+		Message: "Select runs to delete",
+		Options: candidates,
+	}, &selected)
+	if err != nil {
+		return nil, fmt.Errorf("could not prompt: %w", err)
+	}
+	if len(selected) == 0 {
+		return nil, errors.New("no runs selected")
+	}
+
+	selectedRuns := make([]shared.Run, len(selected))
+	for i, candidate := range selected {
+		selectedRuns[i] = runForCandidate[candidate]
+	}
+
+	return selectedRuns, nil
+}
+
+func resolveRunsWithFilter(client *api.Client, repo ghrepo.Interface, opts *DeleteOptions) ([]shared.Run, error) {
+	var workflowID int64
+	if opts.WorkflowSelector != "" {
+		workflow, err := shared.ResolveWorkflow(opts.IO, client, repo, false, opts.WorkflowSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve workflow: %w", err)
+		}
+		workflowID = workflow.ID
+	}
+
+	limit := opts.Limit
+	if opts.All {
+		limit = 0
+	}
+
+	var cutoff time.Time
+	if opts.OlderThan > 0 {
+		cutoff = time.Now().Add(-opts.OlderThan)
+	}
+
+	return shared.GetRunsWithFilter(client, repo, workflowID, limit, func(run shared.Run) bool {
+		if opts.Status != "" && string(run.Status) != opts.Status && string(run.Conclusion) != opts.Status {
+			return false
+		}
+		if opts.Branch != "" && run.HeadBranch != opts.Branch {
+			return false
+		}
+		if opts.Event != "" && run.Event != opts.Event {
+			return false
+		}
+		if !cutoff.IsZero() && run.CreatedAt.After(cutoff) {
+			return false
+		}
+		return true
+	})
+}
+
+type deleteResult struct {
+	runID string
+	err   error
+}
+
+// deleteRuns issues the delete calls for runs concurrently, bounded by
+// maxDeleteConcurrency, and returns one result per run in the same order.
+func deleteRuns(client *api.Client, repo ghrepo.Interface, runs []shared.Run, opts *DeleteOptions) []deleteResult {
+	results := make([]deleteResult, len(runs))
+	sem := make(chan struct{}, maxDeleteConcurrency)
+
+	var wg sync.WaitGroup
+	for i, run := range runs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, run shared.Run) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = deleteResult{runID: fmt.Sprintf("%d", run.ID), err: processRun(client, repo, run, opts)}
+		}(i, run)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// processRun deletes run, or, when opts.isTrashing, archives its logs and
+// metadata instead so they can be looked up afterward with `gh run restore`.
+func processRun(client *api.Client, repo ghrepo.Interface, run shared.Run, opts *DeleteOptions) error {
+	if opts.isTrashing() {
+		return trashRun(client, repo, run)
+	}
+	return deleteRun(client, repo, run, opts)
+}
+
+// trashRun archives run's logs and metadata under shared.TrashDir so they
+// can be looked up with `gh run restore`. It does not delete the run.
+func trashRun(client *api.Client, repo ghrepo.Interface, run shared.Run) error {
+	dir := shared.TrashDir(repo)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	logsPath := filepath.Join(dir, fmt.Sprintf("%d.zip", run.ID))
+	if err := downloadRunLogs(client, repo, run.ID, logsPath); err != nil {
+		return fmt.Errorf("failed to download run logs: %w", err)
+	}
+
+	return shared.WriteTrashRecord(repo, shared.TrashRecord{
+		RunID:      run.ID,
+		WorkflowID: run.WorkflowID,
+		HeadSHA:    run.HeadSHA,
+		HeadBranch: run.HeadBranch,
+		CreatedAt:  run.CreatedAt,
+		LogsPath:   logsPath,
+	})
+}
+
+// downloadRunLogs saves the zipped logs for runID to destPath.
+func downloadRunLogs(client *api.Client, repo ghrepo.Interface, runID int64, destPath string) error {
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + fmt.Sprintf("repos/%s/actions/runs/%d/logs", ghrepo.FullName(repo), runID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.HTTP().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+
+	out, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// deleteRun deletes run, and — when opts.Cancel is set and the run hasn't
+// completed — cancels it first (optionally awaiting completion) and retries
+// the delete once.
+func deleteRun(client *api.Client, repo ghrepo.Interface, run shared.Run, opts *DeleteOptions) error {
+	deleteErr := postDeleteRun(client, repo, run.ID)
+	if deleteErr == nil {
+		return nil
+	}
+
+	var httpErr api.HTTPError
+	if !errors.As(deleteErr, &httpErr) || httpErr.StatusCode != 409 {
+		return fmt.Errorf("failed to delete run: %w", deleteErr)
+	}
+
+	if !opts.Cancel {
+		return errors.New("Cannot delete a workflow run that hasn't completed")
+	}
+
+	cancelPath := fmt.Sprintf("repos/%s/actions/runs/%d/cancel", ghrepo.FullName(repo), run.ID)
+	if err := client.REST(repo.RepoHost(), "POST", cancelPath, nil, nil); err != nil {
+		return fmt.Errorf("failed to cancel run: %w", err)
+	}
+
+	if opts.Wait {
+		if err := waitForRunCompletion(client, repo, run.ID, opts.Timeout); err != nil {
+			return err
+		}
+	}
+
+	if err := postDeleteRun(client, repo, run.ID); err != nil {
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 409 {
+			return errors.New("Cannot delete a workflow run that hasn't completed")
+		}
+		return fmt.Errorf("failed to delete run: %w", err)
+	}
+
+	return nil
+}
+
+func postDeleteRun(client *api.Client, repo ghrepo.Interface, runID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/delete", ghrepo.FullName(repo), runID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
+
+// waitForRunCompletion polls the run until it reaches a terminal status,
+// backing off exponentially, bounded by timeout.
+func waitForRunCompletion(client *api.Client, repo ghrepo.Interface, runID int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	backoff := time.Second
+
+	for {
+		run, err := shared.GetRun(client, repo, fmt.Sprintf("%d", runID))
+		if err != nil {
+			return fmt.Errorf("failed to poll run: %w", err)
+		}
+		if run.Status == shared.Completed {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for run %d to finish", timeout, runID)
+		}
+
+		sleep := backoff
+		if remaining := time.Until(deadline); remaining < sleep {
+			sleep = remaining
+		}
+		time.Sleep(sleep)
+
+		backoff *= 2
+		if backoff > 30*time.Second {
+			backoff = 30 * time.Second
+		}
+	}
+}
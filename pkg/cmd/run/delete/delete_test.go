@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
@@ -30,6 +32,7 @@ func TestNewCmdDelete(t *testing.T) {
 			tty:  true,
 			wants: DeleteOptions{
 				Prompt: true,
+				Trash:  true,
 			},
 		},
 		{
@@ -43,6 +46,24 @@ func TestNewCmdDelete(t *testing.T) {
 				RunID: "1234",
 			},
 		},
+		{
+			name: "with multiple args",
+			cli:  "1234 4567",
+			tty:  true,
+			wants: DeleteOptions{
+				RunIDs: []string{"1234", "4567"},
+			},
+		},
+		{
+			name:     "blank nontty with --all",
+			cli:      "--all --status completed",
+			wantsErr: true,
+		},
+		{
+			name:     "multiple args nontty without --yes",
+			cli:      "1234 4567",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +99,10 @@ func TestNewCmdDelete(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			if tt.wants.RunIDs != nil {
+				assert.Equal(t, tt.wants.RunIDs, gotOpts.RunIDs)
+			}
+			assert.Equal(t, tt.wants.Trash, gotOpts.Trash)
 		})
 	}
 }
@@ -86,13 +111,17 @@ func TestRunDelete(t *testing.T) {
 	inProgressRun := shared.TestRun(1234, shared.InProgress, "")
 	completedRun := shared.TestRun(4567, shared.Completed, shared.Failure)
 	tests := []struct {
-		name      string
-		httpStubs func(*httpmock.Registry)
-		askStubs  func(*prompt.AskStubber)
-		opts      *DeleteOptions
-		wantErr   bool
-		wantOut   string
-		errMsg    string
+		name            string
+		nonTTY          bool
+		httpStubs       func(*httpmock.Registry)
+		askStubs        func(*prompt.AskStubber)
+		opts            *DeleteOptions
+		wantErr         bool
+		wantOut         string
+		wantOutContains []string
+		wantOutExcludes []string
+		errMsg          string
+		checkTrash      bool
 	}{
 		{
 			name: "delete run",
@@ -196,13 +225,301 @@ func TestRunDelete(t *testing.T) {
 			},
 			askStubs: func(as *prompt.AskStubber) {
 				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
-				as.StubOne(0)
+				as.StubOne([]string{"1234 (in_progress)"})
 			},
 			wantOut: "✓ Request to delete workflow submitted.\n",
 		},
+		{
+			name: "prompt, multi-select delete",
+			opts: &DeleteOptions{
+				Prompt: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							inProgressRun,
+							shared.TestRun(1235, shared.InProgress, ""),
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1235/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			askStubs: func(as *prompt.AskStubber) {
+				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+				as.StubOne([]string{"1234 (in_progress)", "1235 (in_progress)"})
+			},
+			wantOut: "✓ Deleted run 1234.\n✓ Deleted run 1235.\n",
+		},
+		{
+			name: "filter resolution",
+			opts: &DeleteOptions{
+				Status:    "completed",
+				Confirmed: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							completedRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/4567/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOut: "✓ Request to delete workflow submitted.\n",
+		},
+		{
+			name: "cancel then delete, waits for completion",
+			opts: &DeleteOptions{
+				RunID:   "1234",
+				Cancel:  true,
+				Wait:    true,
+				Timeout: time.Minute,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(409, ""))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(completedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOut: "✓ Request to delete workflow submitted.\n",
+		},
+		{
+			name: "cancel then delete, timeout",
+			opts: &DeleteOptions{
+				RunID:   "1234",
+				Cancel:  true,
+				Wait:    true,
+				Timeout: time.Nanosecond,
+			},
+			wantErr: true,
+			errMsg:  "timed out after 1ns waiting for run 1234 to finish",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(409, ""))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+		},
+		{
+			name: "cancel is a no-op for completed runs",
+			opts: &DeleteOptions{
+				RunID:  "4567",
+				Cancel: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/4567"),
+					httpmock.JSONResponse(completedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/4567/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOut: "✓ Request to delete workflow submitted.\n",
+		},
+		{
+			name: "confirm prompt renders run headers for TTY",
+			opts: &DeleteOptions{
+				RunIDs: []string{"1234", "4567"},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/4567"),
+					httpmock.JSONResponse(completedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/4567/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			askStubs: func(as *prompt.AskStubber) {
+				//nolint:staticcheck // SA1019: as.StubOne is deprecated: use StubPrompt
+				as.StubOne(true)
+			},
+			wantOutContains: []string{"#1234", "#4567", "✓ Deleted run 1234.\n", "✓ Deleted run 4567.\n"},
+		},
+		{
+			name:   "header omitted for non-TTY without --verbose",
+			nonTTY: true,
+			opts: &DeleteOptions{
+				RunID:     "1234",
+				Confirmed: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOutExcludes: []string{"#1234"},
+		},
+		{
+			name:   "header printed for non-TTY with --verbose",
+			nonTTY: true,
+			opts: &DeleteOptions{
+				RunID:     "1234",
+				Confirmed: true,
+				Verbose:   true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOutContains: []string{"#1234"},
+		},
+		{
+			name: "trash run archives metadata and logs without deleting",
+			opts: &DeleteOptions{
+				RunID: "1234",
+				Trash: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/logs"),
+					httpmock.StringResponse("fake zip contents"))
+			},
+			wantOutContains: []string{"Run trashed; restore it with `gh run restore 1234`."},
+			checkTrash:      true,
+		},
+		{
+			name: "purge overrides trash and deletes the run",
+			opts: &DeleteOptions{
+				RunID: "1234",
+				Trash: true,
+				Purge: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			wantOut: "✓ Request to delete workflow submitted.\n",
+		},
+		{
+			name: "concurrency path, partial failure",
+			opts: &DeleteOptions{
+				RunIDs:    []string{"1234", "4567"},
+				Confirmed: true,
+			},
+			wantErr: true,
+			errMsg:  "failed to delete 1 of 2 runs",
+			wantOut: "✓ Deleted run 1234.\n",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/delete"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/4567"),
+					httpmock.JSONResponse(completedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/4567/delete"),
+					httpmock.StatusStringResponse(409, ""))
+			},
+		},
 	}
 
 	for _, tt := range tests {
+		t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 		reg := &httpmock.Registry{}
 		tt.httpStubs(reg)
 		tt.opts.HttpClient = func() (*http.Client, error) {
@@ -210,8 +527,8 @@ func TestRunDelete(t *testing.T) {
 		}
 
 		ios, _, stdout, _ := iostreams.Test()
-		ios.SetStdoutTTY(true)
-		ios.SetStdinTTY(true)
+		ios.SetStdoutTTY(!tt.nonTTY)
+		ios.SetStdinTTY(!tt.nonTTY)
 		tt.opts.IO = ios
 		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
 			return ghrepo.FromFullName("OWNER/REPO")
@@ -234,7 +551,31 @@ func TestRunDelete(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
-			assert.Equal(t, tt.wantOut, stdout.String())
+			if len(tt.wantOutContains) > 0 || len(tt.wantOutExcludes) > 0 {
+				for _, frag := range tt.wantOutContains {
+					assert.Contains(t, stdout.String(), frag)
+				}
+				for _, frag := range tt.wantOutExcludes {
+					assert.NotContains(t, stdout.String(), frag)
+				}
+			} else {
+				assert.Equal(t, tt.wantOut, stdout.String())
+			}
+			if tt.checkTrash {
+				repo, err := tt.opts.BaseRepo()
+				assert.NoError(t, err)
+
+				record, err := shared.ReadTrashRecord(repo, "1234")
+				assert.NoError(t, err)
+				assert.Equal(t, inProgressRun.ID, record.RunID)
+				assert.Equal(t, inProgressRun.WorkflowID, record.WorkflowID)
+				assert.Equal(t, inProgressRun.HeadSHA, record.HeadSHA)
+				assert.Equal(t, inProgressRun.HeadBranch, record.HeadBranch)
+
+				logs, err := os.ReadFile(record.LogsPath)
+				assert.NoError(t, err)
+				assert.Equal(t, "fake zip contents", string(logs))
+			}
 			reg.Verify(t)
 		})
 	}
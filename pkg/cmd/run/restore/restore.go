@@ -0,0 +1,101 @@
+package restore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RestoreOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID    string
+	Dispatch bool
+}
+
+func NewCmdRestore(f *cmdutil.Factory, runF func(*RestoreOptions) error) *cobra.Command {
+	opts := &RestoreOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore <run-id>",
+		Short: "Show metadata for a trashed workflow run",
+		Long: heredoc.Doc(`
+			Look up a run previously saved with "gh run delete --trash" and print
+			its stored metadata and the path to its archived logs.
+
+			GitHub has no API to undelete a run, so --dispatch re-runs the same
+			workflow on the same ref instead of restoring the original run.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.RunID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runRestore(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Dispatch, "dispatch", false, "Re-run the trashed run's workflow on the same ref")
+
+	return cmd
+}
+
+func runRestore(opts *RestoreOptions) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	record, err := shared.ReadTrashRecord(repo, opts.RunID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Trashed run %d (workflow %d) on %s, created at %s\n", record.RunID, record.WorkflowID, record.HeadBranch, record.CreatedAt.Format(time.RFC3339))
+	fmt.Fprintf(opts.IO.Out, "Logs saved to %s\n", record.LogsPath)
+
+	if !opts.Dispatch {
+		return nil
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	requestBody, err := json.Marshal(struct {
+		Ref string `json:"ref"`
+	}{Ref: record.HeadBranch})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/workflows/%d/dispatches", ghrepo.FullName(repo), record.WorkflowID)
+	if err := client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestBody), nil); err != nil {
+		return fmt.Errorf("failed to re-dispatch workflow: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Re-dispatched workflow %d on %s.\n", cs.SuccessIcon(), record.WorkflowID, record.HeadBranch)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package restore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRestore(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    RestoreOptions
+		wantsErr bool
+	}{
+		{
+			name:     "no arg",
+			cli:      "",
+			wantsErr: true,
+		},
+		{
+			name: "with arg",
+			cli:  "1234",
+			wants: RestoreOptions{
+				RunID: "1234",
+			},
+		},
+		{
+			name: "with dispatch",
+			cli:  "1234 --dispatch",
+			wants: RestoreOptions{
+				RunID:    "1234",
+				Dispatch: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *RestoreOptions
+			cmd := NewCmdRestore(f, func(opts *RestoreOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.Dispatch, gotOpts.Dispatch)
+		})
+	}
+}
+
+func TestRunRestore(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *RestoreOptions
+		setupTrash bool
+		httpStubs  func(*httpmock.Registry)
+		wantErr    bool
+		errMsg     string
+		wantOut    string
+	}{
+		{
+			name: "no trashed run found",
+			opts: &RestoreOptions{
+				RunID: "1234",
+			},
+			wantErr: true,
+			errMsg:  "no trashed run found with ID 1234",
+		},
+		{
+			name: "prints stored metadata",
+			opts: &RestoreOptions{
+				RunID: "1234",
+			},
+			setupTrash: true,
+			wantOut:    "Trashed run 1234 (workflow 123) on main, created at 2021-02-23T16:00:00Z\nLogs saved to %s\n",
+		},
+		{
+			name: "dispatch re-runs the workflow",
+			opts: &RestoreOptions{
+				RunID:    "1234",
+				Dispatch: true,
+			},
+			setupTrash: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/123/dispatches"),
+					httpmock.RESTPayload(204, "", func(payload []byte) {
+						assert.Equal(t, `{"ref":"main"}`, string(payload))
+					}))
+			},
+			wantOut: "Trashed run 1234 (workflow 123) on main, created at 2021-02-23T16:00:00Z\nLogs saved to %s\n✓ Re-dispatched workflow 123 on main.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			var logsPath string
+			if tt.setupTrash {
+				repo, _ := tt.opts.BaseRepo()
+				createdAt, err := time.Parse(time.RFC3339, "2021-02-23T16:00:00Z")
+				assert.NoError(t, err)
+				logsPath = filepath.Join(shared.TrashDir(repo), "1234.zip")
+				err = shared.WriteTrashRecord(repo, shared.TrashRecord{
+					RunID:      1234,
+					WorkflowID: 123,
+					HeadSHA:    "abc123",
+					HeadBranch: "main",
+					CreatedAt:  createdAt,
+					LogsPath:   logsPath,
+				})
+				assert.NoError(t, err)
+			}
+
+			err := runRestore(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errMsg != "" {
+					assert.Equal(t, tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf(tt.wantOut, logsPath), stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
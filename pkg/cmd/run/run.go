@@ -0,0 +1,23 @@
+package run
+
+import (
+	cmdDelete "github.com/cli/cli/v2/pkg/cmd/run/delete"
+	cmdRestore "github.com/cli/cli/v2/pkg/cmd/run/restore"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <command>",
+		Short: "Delete and restore workflow runs",
+		Long:  "Delete workflow runs from GitHub Actions, and look up runs saved to the local trash.",
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdRestore.NewCmdRestore(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// RenderRunHeader formats a short, human-readable summary of a single
+// workflow run — workflow, event, branch, actor, status/conclusion,
+// duration, and URL — for display before a consequential action such as
+// `gh run delete`.
+func RenderRunHeader(cs *iostreams.ColorScheme, run Run) string {
+	symbol, symbolColor := Symbol(cs, run.Status, run.Conclusion)
+	title := fmt.Sprintf("%s %s %s", symbolColor(symbol), run.WorkflowName, cs.Gray(fmt.Sprintf("#%d", run.ID)))
+	meta := fmt.Sprintf("%s · %s · %s", run.Event, run.HeadBranch, run.Actor.Login)
+
+	duration := "unknown duration"
+	if !run.CreatedAt.IsZero() {
+		end := run.UpdatedAt
+		if end.Before(run.CreatedAt) {
+			end = run.CreatedAt
+		}
+		duration = end.Sub(run.CreatedAt).Truncate(time.Second).String()
+	}
+
+	return fmt.Sprintf("%s\n%s\n%s\n%s", title, meta, duration, run.URL)
+}
@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// TrashRecord is the metadata `gh run delete --trash` saves locally for a
+// trashed run.
+type TrashRecord struct {
+	RunID      int64     `json:"run_id"`
+	WorkflowID int64     `json:"workflow_id"`
+	HeadSHA    string    `json:"head_sha"`
+	HeadBranch string    `json:"head_branch"`
+	CreatedAt  time.Time `json:"created_at"`
+	LogsPath   string    `json:"logs_path"`
+}
+
+// TrashDir returns the per-repo directory `gh run delete --trash` and
+// `gh run restore` use to store run metadata and logs.
+func TrashDir(repo ghrepo.Interface) string {
+	return filepath.Join(config.StateDir(), "run-trash", repo.RepoOwner(), repo.RepoName())
+}
+
+// WriteTrashRecord saves record as JSON under TrashDir.
+func WriteTrashRecord(repo ghrepo.Interface, record TrashRecord) error {
+	dir := TrashDir(repo)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%d.json", record.RunID))
+	return os.WriteFile(path, data, 0600)
+}
+
+// ReadTrashRecord loads the metadata previously saved for runID.
+func ReadTrashRecord(repo ghrepo.Interface, runID string) (*TrashRecord, error) {
+	path := filepath.Join(TrashDir(repo), runID+".json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no trashed run found with ID %s", runID)
+		}
+		return nil, err
+	}
+
+	var record TrashRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse trash metadata: %w", err)
+	}
+
+	return &record, nil
+}